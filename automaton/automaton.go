@@ -0,0 +1,87 @@
+// Package automaton implements elementary (one-dimensional, two-state)
+// cellular automata as described by Wolfram's rule numbering scheme.
+package automaton
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// NewRule decodes an elementary cellular automaton rule number (0-255)
+// into a function mapping a (left, center, right) neighborhood to the
+// next state of the center cell. Bit i of n holds the output for the
+// neighborhood pattern whose binary representation equals i, e.g. bit 6
+// (pattern 110) holds the output for (left=1, center=1, right=0).
+func NewRule(n uint8) func(l, c, r int) int {
+	return func(l, c, r int) int {
+		pattern := uint8((l << 2) | (c << 1) | r)
+		return int((n >> pattern) & 1)
+	}
+}
+
+// Run drives an elementary automaton for the given number of generations
+// over a tape of len(initial) cells, handing each generation (including
+// the initial one) to r in order and closing r once done. boundary
+// controls how neighbors beyond the ends of the tape are treated. When
+// workers is greater than 1, each generation's cells are computed by up to
+// that many goroutines working on contiguous chunks of the tape. Two buffers
+// are reused across generations rather than allocated per step.
+func Run(rule func(l, c, r int) int, generations int, initial []int, boundary Boundary, workers int, r Renderer) error {
+	size := len(initial)
+	cur := make([]int, size)
+	next := make([]int, size)
+	copy(cur, initial)
+
+	r.AddGeneration(cur)
+	for gen := 0; gen < generations; gen++ {
+		step(rule, cur, next, boundary, workers)
+		r.AddGeneration(next)
+		cur, next = next, cur
+	}
+	return r.Close()
+}
+
+// step computes one generation from cur into next, splitting the tape
+// into at most `workers` contiguous chunks of ceil(size/workers) cells,
+// each run on its own goroutine, when workers > 1.
+func step(rule func(l, c, r int) int, cur, next []int, boundary Boundary, workers int) {
+	size := len(cur)
+	if workers <= 1 {
+		stepRange(rule, cur, next, boundary, 0, size)
+		return
+	}
+
+	chunk := (size + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < size; start += chunk {
+		end := start + chunk
+		if end > size {
+			end = size
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			stepRange(rule, cur, next, boundary, start, end)
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+func stepRange(rule func(l, c, r int) int, cur, next []int, boundary Boundary, start, end int) {
+	for i := start; i < end; i++ {
+		left, right := boundary.neighbor(cur, i)
+		next[i] = rule(left, cur[i], right)
+	}
+}
+
+func printCells(w io.Writer, cells []int) {
+	for _, c := range cells {
+		if c == 1 {
+			fmt.Fprint(w, "x ")
+		} else {
+			fmt.Fprint(w, "  ")
+		}
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,30 @@
+package automaton
+
+import "testing"
+
+func BenchmarkRun_Sequential_10k(b *testing.B)  { benchmarkRun(b, 10_000, 1) }
+func BenchmarkRun_Parallel_10k(b *testing.B)    { benchmarkRun(b, 10_000, 4) }
+func BenchmarkRun_Sequential_100k(b *testing.B) { benchmarkRun(b, 100_000, 1) }
+func BenchmarkRun_Parallel_100k(b *testing.B)   { benchmarkRun(b, 100_000, 4) }
+func BenchmarkRun_Sequential_1M(b *testing.B)   { benchmarkRun(b, 1_000_000, 1) }
+func BenchmarkRun_Parallel_1M(b *testing.B)     { benchmarkRun(b, 1_000_000, 4) }
+
+func benchmarkRun(b *testing.B, size, workers int) {
+	rule := NewRule(110)
+	initial := make([]int, size)
+	initial[size-1] = 1
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Run(rule, 10, initial, BoundaryZero, workers, discardRenderer{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// discardRenderer implements Renderer by doing nothing, so benchmarks
+// measure generation computation rather than output formatting.
+type discardRenderer struct{}
+
+func (discardRenderer) AddGeneration(cells []int) {}
+func (discardRenderer) Close() error              { return nil }
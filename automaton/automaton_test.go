@@ -0,0 +1,31 @@
+package automaton
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRunWorkersMatchSequential(t *testing.T) {
+	const size = 37 // not evenly divisible by the worker counts below
+	initial := make([]int, size)
+	initial[size-1] = 1
+
+	for _, ruleNum := range []uint8{30, 110, 184} {
+		rule := NewRule(ruleNum)
+
+		var want bytes.Buffer
+		if err := Run(rule, 25, initial, BoundaryWrap, 1, NewTextRenderer(&want)); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, workers := range []int{4, 7} {
+			var got bytes.Buffer
+			if err := Run(rule, 25, initial, BoundaryWrap, workers, NewTextRenderer(&got)); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != want.String() {
+				t.Errorf("rule %d, workers=%d: output differs from workers=1 sequential run", ruleNum, workers)
+			}
+		}
+	}
+}
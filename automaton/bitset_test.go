@@ -0,0 +1,31 @@
+package automaton
+
+import "testing"
+
+func TestBitsetStepMatchesIntRule(t *testing.T) {
+	const size = 130 // spans three 64-bit words
+	for _, ruleNum := range []uint8{30, 90, 110, 184} {
+		rule := NewRule(ruleNum)
+
+		cells := make([]int, size)
+		cells[size-1] = 1
+
+		bits := NewBitset(size)
+		bits.Set(size-1, 1)
+
+		for gen := 0; gen < 20; gen++ {
+			next := make([]int, size)
+			for i := 0; i < size; i++ {
+				stepRange(rule, cells, next, BoundaryZero, i, i+1)
+			}
+			cells = next
+			bits.Step(ruleNum)
+
+			for i := 0; i < size; i++ {
+				if got, want := bits.Get(i), cells[i]; got != want {
+					t.Fatalf("rule %d, gen %d, cell %d: Bitset.Get = %d, want %d", ruleNum, gen, i, got, want)
+				}
+			}
+		}
+	}
+}
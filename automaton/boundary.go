@@ -0,0 +1,71 @@
+package automaton
+
+import "fmt"
+
+// Boundary selects how a generation step treats neighbors that fall off
+// the ends of the tape.
+type Boundary int
+
+const (
+	// BoundaryZero treats out-of-range neighbors as 0 (the default).
+	BoundaryZero Boundary = iota
+	// BoundaryOne treats out-of-range neighbors as 1.
+	BoundaryOne
+	// BoundaryWrap treats the tape as a ring, so the left neighbor of
+	// cell 0 is the last cell and vice versa.
+	BoundaryWrap
+	// BoundaryReflect treats the edge itself as the neighbor, so cell 0's
+	// left neighbor is cell 0.
+	BoundaryReflect
+)
+
+// ParseBoundary converts a flag value ("zero", "one", "wrap", "reflect")
+// into a Boundary.
+func ParseBoundary(s string) (Boundary, error) {
+	switch s {
+	case "zero":
+		return BoundaryZero, nil
+	case "one":
+		return BoundaryOne, nil
+	case "wrap":
+		return BoundaryWrap, nil
+	case "reflect":
+		return BoundaryReflect, nil
+	default:
+		return 0, fmt.Errorf("automaton: unknown boundary %q", s)
+	}
+}
+
+func (b Boundary) neighbor(cells []int, i int) (left, right int) {
+	size := len(cells)
+	switch b {
+	case BoundaryWrap:
+		left = cells[(i-1+size)%size]
+		right = cells[(i+1)%size]
+	case BoundaryOne:
+		left, right = 1, 1
+		if i > 0 {
+			left = cells[i-1]
+		}
+		if i < size-1 {
+			right = cells[i+1]
+		}
+	case BoundaryReflect:
+		left, right = cells[i], cells[i]
+		if i > 0 {
+			left = cells[i-1]
+		}
+		if i < size-1 {
+			right = cells[i+1]
+		}
+	default: // BoundaryZero
+		left, right = 0, 0
+		if i > 0 {
+			left = cells[i-1]
+		}
+		if i < size-1 {
+			right = cells[i+1]
+		}
+	}
+	return left, right
+}
@@ -0,0 +1,32 @@
+package automaton
+
+import "testing"
+
+func TestBoundaryNeighbor(t *testing.T) {
+	cells := []int{1, 0, 1, 1, 0} // size 5, cells[0]=1, cells[size-1]=0
+
+	tests := []struct {
+		name        string
+		b           Boundary
+		i           int
+		left, right int
+	}{
+		{"zero/left edge", BoundaryZero, 0, 0, 0},
+		{"zero/right edge", BoundaryZero, 4, 1, 0},
+		{"one/left edge", BoundaryOne, 0, 1, 0},
+		{"one/right edge", BoundaryOne, 4, 1, 1},
+		{"wrap/left edge", BoundaryWrap, 0, 0, 0},
+		{"wrap/right edge", BoundaryWrap, 4, 1, 1},
+		{"reflect/left edge", BoundaryReflect, 0, 1, 0},
+		{"reflect/right edge", BoundaryReflect, 4, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := tt.b.neighbor(cells, tt.i)
+			if left != tt.left || right != tt.right {
+				t.Errorf("neighbor(%d) = (%d, %d), want (%d, %d)", tt.i, left, right, tt.left, tt.right)
+			}
+		})
+	}
+}
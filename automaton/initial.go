@@ -0,0 +1,62 @@
+package automaton
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ValidateSize returns an error if size is not a positive number of
+// cells. Callers should check this before passing size to InitialPattern,
+// which can panic on a non-positive size (e.g. "single" indexes cell
+// size-1, and "random" makes a size-length slice).
+func ValidateSize(size int) error {
+	if size <= 0 {
+		return fmt.Errorf("automaton: size must be positive, got %d", size)
+	}
+	return nil
+}
+
+// InitialPattern builds a starting tape of the given size from one of the
+// supported kinds:
+//
+//   - "random": each cell is independently 0 or 1, seeded by seed.
+//   - "single": every cell is 0 except the rightmost, matching the
+//     classic single-seed start for rule 110.
+//   - a bit string such as "0010110...": parsed literally, left-padded
+//     with zeros to size if shorter, and must not exceed size.
+func InitialPattern(kind string, size int, seed int64) ([]int, error) {
+	switch kind {
+	case "random":
+		rng := rand.New(rand.NewSource(seed))
+		cells := make([]int, size)
+		for i := range cells {
+			cells[i] = rng.Intn(2)
+		}
+		return cells, nil
+	case "single":
+		cells := make([]int, size)
+		cells[size-1] = 1
+		return cells, nil
+	default:
+		return parseBitString(kind, size)
+	}
+}
+
+func parseBitString(s string, size int) ([]int, error) {
+	if len(s) > size {
+		return nil, fmt.Errorf("automaton: initial pattern %q longer than size %d", s, size)
+	}
+	cells := make([]int, size)
+	offset := size - len(s)
+	for i, b := range s {
+		switch b {
+		case '0':
+			cells[offset+i] = 0
+		case '1':
+			cells[offset+i] = 1
+		default:
+			return nil, fmt.Errorf("automaton: invalid character %q in initial pattern %q", b, s)
+		}
+	}
+	return cells, nil
+}
@@ -0,0 +1,84 @@
+package automaton
+
+import "testing"
+
+func TestInitialPatternSingle(t *testing.T) {
+	cells, err := InitialPattern("single", 5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 0, 0, 0, 1}; !equalInts(cells, want) {
+		t.Errorf("got %v, want %v", cells, want)
+	}
+}
+
+func TestInitialPatternRandom(t *testing.T) {
+	cells, err := InitialPattern("random", 100, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 100 {
+		t.Fatalf("got %d cells, want 100", len(cells))
+	}
+	for i, c := range cells {
+		if c != 0 && c != 1 {
+			t.Fatalf("cell %d = %d, want 0 or 1", i, c)
+		}
+	}
+
+	again, err := InitialPattern("random", 100, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalInts(cells, again) {
+		t.Errorf("same seed produced different patterns:\n%v\n%v", cells, again)
+	}
+}
+
+func TestInitialPatternBitString(t *testing.T) {
+	tests := []struct {
+		name string
+		bits string
+		size int
+		want []int
+	}{
+		{"exact length", "101", 3, []int{1, 0, 1}},
+		{"left-padded", "11", 5, []int{0, 0, 0, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cells, err := InitialPattern(tt.bits, tt.size, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalInts(cells, tt.want) {
+				t.Errorf("got %v, want %v", cells, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitialPatternBitStringErrors(t *testing.T) {
+	t.Run("longer than size", func(t *testing.T) {
+		if _, err := InitialPattern("1111", 3, 0); err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+	})
+	t.Run("invalid character", func(t *testing.T) {
+		if _, err := InitialPattern("102", 3, 0); err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+	})
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
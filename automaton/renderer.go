@@ -0,0 +1,183 @@
+package automaton
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+)
+
+// Renderer accumulates the generations of an automaton run and produces
+// some output from them. AddGeneration is called once per generation, in
+// order; Close must be called once afterwards to flush or encode the
+// result.
+type Renderer interface {
+	AddGeneration(cells []int)
+	Close() error
+}
+
+// TextRenderer writes each generation as a line of plain-text cells
+// ("x " for alive, "  " for dead), one per generation.
+type TextRenderer struct {
+	w io.Writer
+}
+
+// NewTextRenderer returns a Renderer that writes plain text to w.
+func NewTextRenderer(w io.Writer) *TextRenderer { return &TextRenderer{w: w} }
+
+func (r *TextRenderer) AddGeneration(cells []int) { printCells(r.w, cells) }
+
+// Close is a no-op; TextRenderer writes as it goes.
+func (r *TextRenderer) Close() error { return nil }
+
+// ANSIRenderer is like TextRenderer but draws alive cells as a colored
+// block using ANSI escape codes, for terminals that support them.
+type ANSIRenderer struct {
+	w io.Writer
+}
+
+// NewANSIRenderer returns a Renderer that writes ANSI-colored text to w.
+func NewANSIRenderer(w io.Writer) *ANSIRenderer { return &ANSIRenderer{w: w} }
+
+func (r *ANSIRenderer) AddGeneration(cells []int) {
+	for _, c := range cells {
+		if c == 1 {
+			fmt.Fprint(r.w, "\x1b[42m  \x1b[0m")
+		} else {
+			fmt.Fprint(r.w, "  ")
+		}
+	}
+	fmt.Fprintln(r.w)
+}
+
+// Close is a no-op; ANSIRenderer writes as it goes.
+func (r *ANSIRenderer) Close() error { return nil }
+
+// imageRenderer buffers every generation as a row of cells and, on
+// Close, hands the full spacetime diagram to an encode function. It
+// backs the PBM, PPM, and PNG renderers, which only differ in encoding.
+type imageRenderer struct {
+	w      io.Writer
+	rows   [][]int
+	encode func(w io.Writer, rows [][]int) error
+}
+
+func (r *imageRenderer) AddGeneration(cells []int) {
+	row := make([]int, len(cells))
+	copy(row, cells)
+	r.rows = append(r.rows, row)
+}
+
+func (r *imageRenderer) Close() error { return r.encode(r.w, r.rows) }
+
+// NewPBMRenderer returns a Renderer that encodes the spacetime diagram as
+// a plain (P1) PBM image on Close, one pixel per cell.
+func NewPBMRenderer(w io.Writer) Renderer {
+	return &imageRenderer{w: w, encode: encodePBM}
+}
+
+// NewPPMRenderer returns a Renderer that encodes the spacetime diagram as
+// a plain (P3) PPM image on Close, one pixel per cell.
+func NewPPMRenderer(w io.Writer) Renderer {
+	return &imageRenderer{w: w, encode: encodePPM}
+}
+
+// NewPNGRenderer returns a Renderer that encodes the spacetime diagram as
+// a PNG image on Close, one pixel per cell.
+func NewPNGRenderer(w io.Writer) Renderer {
+	return &imageRenderer{w: w, encode: encodePNG}
+}
+
+func encodePBM(w io.Writer, rows [][]int) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	width, height := len(rows[0]), len(rows)
+	if _, err := fmt.Fprintf(w, "P1\n%d %d\n", width, height); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for i, c := range row {
+			if i > 0 {
+				fmt.Fprint(w, " ")
+			}
+			fmt.Fprint(w, c)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func encodePPM(w io.Writer, rows [][]int) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	width, height := len(rows[0]), len(rows)
+	if _, err := fmt.Fprintf(w, "P3\n%d %d\n255\n", width, height); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, c := range row {
+			if c == 1 {
+				fmt.Fprint(w, "0 0 0 ")
+			} else {
+				fmt.Fprint(w, "255 255 255 ")
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func encodePNG(w io.Writer, rows [][]int) error {
+	img := rowsToImage(rows)
+	return png.Encode(w, img)
+}
+
+func rowsToImage(rows [][]int) *image.Gray {
+	if len(rows) == 0 {
+		return image.NewGray(image.Rect(0, 0, 0, 0))
+	}
+	width, height := len(rows[0]), len(rows)
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y, row := range rows {
+		for x, c := range row {
+			if c == 1 {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	return img
+}
+
+// gifRenderer encodes one GIF frame per generation, so the result
+// animates through the automaton's history rather than showing it as a
+// single static spacetime diagram.
+type gifRenderer struct {
+	w   io.Writer
+	out gif.GIF
+}
+
+// NewGIFRenderer returns a Renderer that encodes one animated GIF frame
+// per generation on Close.
+func NewGIFRenderer(w io.Writer) Renderer {
+	return &gifRenderer{w: w}
+}
+
+func (r *gifRenderer) AddGeneration(cells []int) {
+	palette := color.Palette{color.White, color.Black}
+	frame := image.NewPaletted(image.Rect(0, 0, len(cells), 1), palette)
+	for x, c := range cells {
+		frame.SetColorIndex(x, 0, uint8(c))
+	}
+	r.out.Image = append(r.out.Image, frame)
+	r.out.Delay = append(r.out.Delay, 10)
+}
+
+func (r *gifRenderer) Close() error {
+	return gif.EncodeAll(r.w, &r.out)
+}
@@ -0,0 +1,95 @@
+package automaton
+
+import (
+	"bytes"
+	"image/gif"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestTextRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewTextRenderer(&buf)
+	r.AddGeneration([]int{1, 0, 1})
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "x   x \n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestANSIRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewANSIRenderer(&buf)
+	r.AddGeneration([]int{1, 0})
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "\x1b[42m") {
+		t.Errorf("expected alive cell to carry an ANSI color escape, got %q", out)
+	}
+	if strings.Count(out, "\x1b[42m") != 1 {
+		t.Errorf("expected exactly one colored cell, got %q", out)
+	}
+}
+
+func TestEncodePBM(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodePBM(&buf, [][]int{{1, 0}, {0, 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "P1\n2 2\n1 0\n0 1\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestEncodePPM(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodePPM(&buf, [][]int{{1, 0}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "P3\n2 1\n255\n0 0 0 255 255 255 \n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestPNGRendererDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewPNGRenderer(&buf)
+	r.AddGeneration([]int{1, 0, 1})
+	r.AddGeneration([]int{0, 1, 0})
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bounds := img.Bounds()
+	if w, h := bounds.Dx(), bounds.Dy(); w != 3 || h != 2 {
+		t.Errorf("decoded PNG is %dx%d, want 3x2", w, h)
+	}
+}
+
+func TestGIFRendererFrames(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewGIFRenderer(&buf)
+	r.AddGeneration([]int{1, 0, 1})
+	r.AddGeneration([]int{0, 1, 0})
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	g, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 2 {
+		t.Fatalf("decoded GIF has %d frames, want 2", len(g.Image))
+	}
+	if w := g.Image[0].Bounds().Dx(); w != 3 {
+		t.Errorf("frame width = %d, want 3", w)
+	}
+}
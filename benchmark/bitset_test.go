@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RaphaeleL/ris/automaton"
+)
+
+func TestRunBitsetMatchesRun(t *testing.T) {
+	initial, err := automaton.InitialPattern("single", 40, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wantBuf bytes.Buffer
+	if err := automaton.Run(automaton.NewRule(110), 15, initial, automaton.BoundaryZero, 1, automaton.NewTextRenderer(&wantBuf)); err != nil {
+		t.Fatal(err)
+	}
+
+	var gotBuf bytes.Buffer
+	if err := runBitset(110, 15, initial, automaton.NewTextRenderer(&gotBuf)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := gotBuf.String(), wantBuf.String(); got != want {
+		t.Errorf("runBitset output differs from automaton.Run:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
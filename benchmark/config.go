@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"github.com/RaphaeleL/ris/automaton"
+)
+
+// Experiment describes one automaton run as loaded from a TOML config
+// file: the rule, tape, and boundary to simulate, and where to write the
+// result. Fields left zero fall back to the same defaults as the -size,
+// -generations, etc. flags, except Rule: a zero Rule runs rule 0, not the
+// -rule default of 110.
+type Experiment struct {
+	Rule        uint8  `toml:"rule"`
+	Size        int    `toml:"size"`
+	Generations int    `toml:"generations"`
+	Init        string `toml:"init"`
+	Seed        int64  `toml:"seed"`
+	Boundary    string `toml:"boundary"`
+	Output      string `toml:"output"`
+	Format      string `toml:"format"`
+	Workers     int    `toml:"workers"`
+	Bitset      bool   `toml:"bitset"`
+}
+
+// ExperimentConfig is the top-level shape of a TOML experiment file: a
+// list of [[experiment]] tables, each describing one batch run.
+type ExperimentConfig struct {
+	Experiment []Experiment `toml:"experiment"`
+}
+
+// runConfig loads the TOML file at path and executes every experiment it
+// describes, in order, writing each one's output as specified.
+func runConfig(path string) error {
+	var cfg ExperimentConfig
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		return fmt.Errorf("loading %s: %w", path, err)
+	}
+	for i, exp := range cfg.Experiment {
+		if err := runExperiment(exp); err != nil {
+			return fmt.Errorf("experiment %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func runExperiment(exp Experiment) error {
+	size := exp.Size
+	if size == 0 {
+		size = 50
+	}
+	if err := automaton.ValidateSize(size); err != nil {
+		return err
+	}
+	generations := exp.Generations
+	if generations == 0 {
+		generations = size
+	}
+	pattern := exp.Init
+	if pattern == "" {
+		pattern = "single"
+	}
+	boundaryMode := exp.Boundary
+	if boundaryMode == "" {
+		boundaryMode = "zero"
+	}
+	format := exp.Format
+	if format == "" {
+		format = "text"
+	}
+	workers := exp.Workers
+	if workers == 0 {
+		workers = 1
+	}
+
+	initial, err := automaton.InitialPattern(pattern, size, exp.Seed)
+	if err != nil {
+		return err
+	}
+	b, err := automaton.ParseBoundary(boundaryMode)
+	if err != nil {
+		return err
+	}
+
+	w, closeOutput, err := openOutput(exp.Output)
+	if err != nil {
+		return err
+	}
+	defer closeOutput()
+
+	renderer, err := newRenderer(format, w)
+	if err != nil {
+		return err
+	}
+
+	if exp.Bitset {
+		if b != automaton.BoundaryZero {
+			return fmt.Errorf("bitset only supports boundary=zero")
+		}
+		return runBitset(exp.Rule, generations, initial, renderer)
+	}
+
+	return automaton.Run(automaton.NewRule(exp.Rule), generations, initial, b, workers, renderer)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestLoadConfigParsesExperimentList(t *testing.T) {
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "experiments.toml")
+	doc := `
+[[experiment]]
+rule = 30
+
+[[experiment]]
+rule = 110
+workers = 4
+`
+	if err := os.WriteFile(cfgPath, []byte(doc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg ExperimentConfig
+	if _, err := toml.DecodeFile(cfgPath, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Experiment) != 2 {
+		t.Fatalf("got %d experiments, want 2", len(cfg.Experiment))
+	}
+	if cfg.Experiment[0].Rule != 30 {
+		t.Errorf("experiment 0 rule = %d, want 30", cfg.Experiment[0].Rule)
+	}
+	if cfg.Experiment[1].Workers != 4 {
+		t.Errorf("experiment 1 workers = %d, want 4", cfg.Experiment[1].Workers)
+	}
+}
+
+func TestRunExperimentRejectsNegativeSize(t *testing.T) {
+	err := runExperiment(Experiment{Rule: 110, Size: -3})
+	if err == nil {
+		t.Fatal("expected an error for a negative size, got nil")
+	}
+}
+
+func TestRunConfig(t *testing.T) {
+	dir := t.TempDir()
+	outA := filepath.Join(dir, "a.txt")
+	outB := filepath.Join(dir, "b.txt")
+
+	doc := `
+[[experiment]]
+rule = 90
+size = 10
+generations = 2
+init = "single"
+boundary = "wrap"
+output = "` + filepath.ToSlash(outA) + `"
+format = "text"
+
+[[experiment]]
+rule = 110
+output = "` + filepath.ToSlash(outB) + `"
+`
+	cfgPath := filepath.Join(dir, "experiments.toml")
+	if err := os.WriteFile(cfgPath, []byte(doc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runConfig(cfgPath); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := os.ReadFile(outA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Count(string(a), "\n"), 3; got != want { // initial + 2 generations
+		t.Errorf("experiment with explicit fields: got %d lines, want %d", got, want)
+	}
+
+	// Second experiment leaves size/generations/init/boundary/format
+	// unset, so defaults (size 50, generations = size) should apply.
+	b, err := os.ReadFile(outB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Count(string(b), "\n"), 51; got != want {
+		t.Errorf("experiment with defaults: got %d lines, want %d", got, want)
+	}
+}